@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package addon renders the nested CloudFormation stack that holds a workload's addons.
+package addon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StackName is the logical ID of a workload's addons nested stack.
+const StackName = "AddonsStack"
+
+// addonsDirName is the workspace-relative directory Copilot looks in for a workload's addons.
+const addonsDirName = "addons"
+
+// Addons represents a workload's addons, the supplementary infrastructure that isn't managed
+// directly by a Copilot manifest.
+type Addons struct {
+	wlName string
+	dir    string
+}
+
+// ParseFromJob returns the addons for a job, reading CloudFormation templates from the job's
+// addons directory under the workspace.
+func ParseFromJob(wlName string) (*Addons, error) {
+	dir := filepath.Join("copilot", wlName, addonsDirName)
+	if _, err := os.Stat(dir); err != nil {
+		return &Addons{wlName: wlName, dir: dir}, &ErrDirNotExist{WlName: wlName, ParentErr: err}
+	}
+	return &Addons{wlName: wlName, dir: dir}, nil
+}
+
+// Template concatenates the raw contents of the *.yml files under the addons directory, in
+// lexical file name order, into a single nested stack template. Top-level sections such as
+// Resources or Outputs that appear in more than one file are not merged.
+func (a *Addons) Template() (string, error) {
+	if _, err := os.Stat(a.dir); err != nil {
+		return "", &ErrDirNotExist{WlName: a.wlName, ParentErr: err}
+	}
+	matches, err := filepath.Glob(filepath.Join(a.dir, "*.yml"))
+	if err != nil {
+		return "", fmt.Errorf("glob addons directory %s: %w", a.dir, err)
+	}
+	var merged string
+	for _, m := range matches {
+		b, err := os.ReadFile(m)
+		if err != nil {
+			return "", fmt.Errorf("read addons file %s: %w", m, err)
+		}
+		merged += string(b)
+	}
+	return merged, nil
+}
+
+// ErrDirNotExist occurs when a workload doesn't have an addons directory.
+type ErrDirNotExist struct {
+	WlName    string
+	ParentErr error
+}
+
+func (e *ErrDirNotExist) Error() string {
+	return fmt.Sprintf("workload %s does not have an addons directory", e.WlName)
+}
+
+// Output describes a CloudFormation Output exposed by a workload's addons stack to its
+// parent stack.
+type Output struct {
+	Name            string
+	IsSecret        bool
+	IsManagedPolicy bool
+}
+
+type cfnTemplate struct {
+	Resources map[string]cfnResource `yaml:"Resources"`
+	Outputs   map[string]cfnOutput   `yaml:"Outputs"`
+}
+
+type cfnResource struct {
+	Type string `yaml:"Type"`
+}
+
+type cfnOutput struct {
+	Value cfnRef `yaml:"Value"`
+}
+
+// cfnRef captures either a literal scalar or a short-form `!Ref LogicalID` value.
+type cfnRef struct {
+	LogicalID string
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler so that a `!Ref` tagged scalar resolves to the
+// logical ID it points at, while any other value is treated as a literal with no reference.
+func (r *cfnRef) UnmarshalYAML(node *yaml.Node) error {
+	if node.Tag == "!Ref" {
+		r.LogicalID = node.Value
+	}
+	return nil
+}
+
+// Outputs parses the Outputs section of an addons CloudFormation template and classifies each
+// output by the type of resource it references, so that the parent workload stack knows whether
+// to surface it as a plain variable, a secret ARN, or a managed policy ARN.
+func Outputs(tpl string) ([]Output, error) {
+	var t cfnTemplate
+	if err := yaml.Unmarshal([]byte(tpl), &t); err != nil {
+		return nil, fmt.Errorf("unmarshal addons template to parse outputs: %w", err)
+	}
+	var outputs []Output
+	for name, o := range t.Outputs {
+		res, ok := t.Resources[o.Value.LogicalID]
+		if !ok {
+			outputs = append(outputs, Output{Name: name})
+			continue
+		}
+		switch res.Type {
+		case "AWS::SecretsManager::Secret":
+			outputs = append(outputs, Output{Name: name, IsSecret: true})
+		case "AWS::IAM::ManagedPolicy":
+			outputs = append(outputs, Output{Name: name, IsManagedPolicy: true})
+		default:
+			outputs = append(outputs, Output{Name: name})
+		}
+	}
+	return outputs, nil
+}
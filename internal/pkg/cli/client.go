@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+)
+
+// cfnStackDescriber implements jobRunStackDescriber against the CloudFormation API.
+type cfnStackDescriber struct {
+	client    cloudformationiface.CloudFormationAPI
+	stackName string
+}
+
+// Output returns the value of the named CloudFormation Output from the stack.
+func (d *cfnStackDescriber) Output(name string) (string, error) {
+	resp, err := d.client.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(d.stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe stack %s: %w", d.stackName, err)
+	}
+	if len(resp.Stacks) == 0 {
+		return "", fmt.Errorf("stack %s not found", d.stackName)
+	}
+	for _, out := range resp.Stacks[0].Outputs {
+		if aws.StringValue(out.OutputKey) == name {
+			return aws.StringValue(out.OutputValue), nil
+		}
+	}
+	return "", fmt.Errorf("output %s not found in stack %s", name, d.stackName)
+}
+
+// lambdaClient implements lambdaInvoker against the Lambda API.
+type lambdaClient struct {
+	client lambdaiface.LambdaAPI
+}
+
+// Invoke synchronously invokes functionName with payload and returns its response payload.
+func (c *lambdaClient) Invoke(functionName string, payload []byte) ([]byte, error) {
+	resp, err := c.client.Invoke(&lambda.InvokeInput{
+		FunctionName: aws.String(functionName),
+		Payload:      payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invoke lambda %s: %w", functionName, err)
+	}
+	if resp.FunctionError != nil {
+		return nil, fmt.Errorf("job execution failed: %s", string(resp.Payload))
+	}
+	return resp.Payload, nil
+}
+
+// sfnExecutionDescriber implements executionDescriber against the Step Functions API.
+type sfnExecutionDescriber struct {
+	client sfniface.SFNAPI
+}
+
+// Describe returns the execution's current status and, once it's no longer running, its output
+// (on success) or a "<error>: <cause>" description of the failure (otherwise).
+func (d *sfnExecutionDescriber) Describe(executionARN string) (string, []byte, error) {
+	resp, err := d.client.DescribeExecution(&sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(executionARN),
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+	}
+	status := aws.StringValue(resp.Status)
+	switch status {
+	case sfn.ExecutionStatusRunning:
+		return status, nil, nil
+	case sfn.ExecutionStatusSucceeded:
+		return status, []byte(aws.StringValue(resp.Output)), nil
+	default:
+		return status, []byte(fmt.Sprintf("%s: %s", aws.StringValue(resp.Error), aws.StringValue(resp.Cause))), nil
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+//go:generate mockgen -source interfaces.go -package mocks -destination mocks/mock_interfaces.go
+
+// jobRunStackDescriber reads a CloudFormation Output from a deployed job's stack.
+type jobRunStackDescriber interface {
+	// Output returns the value of the named Output, or an error if the stack or the output
+	// doesn't exist.
+	Output(name string) (string, error)
+}
+
+// lambdaInvoker invokes a Lambda function by name and returns its response payload.
+type lambdaInvoker interface {
+	Invoke(functionName string, payload []byte) ([]byte, error)
+}
+
+// executionDescriber reads the status of a Step Functions execution.
+type executionDescriber interface {
+	// Describe returns the execution's current status (e.g. "RUNNING", "SUCCEEDED", "FAILED").
+	// Once the status is no longer "RUNNING", output holds the execution's result: its output on
+	// success, or a description of the failure otherwise.
+	Describe(executionARN string) (status string, output []byte, err error)
+}
@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cli holds the Copilot CLI's commands.
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/spf13/cobra"
+)
+
+// executionPollInterval is how often Execute checks the Step Functions execution's status while
+// streaming it.
+var executionPollInterval = 2 * time.Second
+
+type jobRunVars struct {
+	appName     string
+	name        string
+	envName     string
+	payloadPath string
+}
+
+// jobRunOpts invokes an on-demand execution of a scheduled job's state machine outside of its
+// normal schedule.
+type jobRunOpts struct {
+	jobRunVars
+
+	stackDescriber jobRunStackDescriber
+	lambda         lambdaInvoker
+	execution      executionDescriber
+	readFile       func(string) ([]byte, error)
+	sleep          func(time.Duration)
+	w              io.Writer
+}
+
+func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+	stackName := fmt.Sprintf("%s-%s-%s", vars.appName, vars.envName, vars.name)
+	return &jobRunOpts{
+		jobRunVars:     vars,
+		stackDescriber: &cfnStackDescriber{client: cloudformation.New(sess), stackName: stackName},
+		lambda:         &lambdaClient{client: lambda.New(sess)},
+		execution:      &sfnExecutionDescriber{client: sfn.New(sess)},
+		readFile:       os.ReadFile,
+		sleep:          time.Sleep,
+		w:              os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the flags the user provided are invalid.
+func (o *jobRunOpts) Validate() error {
+	if o.appName == "" {
+		return errors.New("--app is required")
+	}
+	if o.name == "" {
+		return errors.New("--name is required")
+	}
+	if o.envName == "" {
+		return errors.New("--env is required")
+	}
+	return nil
+}
+
+// invokerResponse is the JSON the invoker Lambda returns after starting the job's state machine
+// execution: the ARN Execute polls for status until the execution completes.
+type invokerResponse struct {
+	ExecutionArn string `json:"executionArn"`
+}
+
+// Execute resolves the job's invoker Lambda, invokes it to start an execution, and streams that
+// execution's status until it completes.
+func (o *jobRunOpts) Execute() error {
+	payload, err := o.payload()
+	if err != nil {
+		return err
+	}
+	functionName, err := o.stackDescriber.Output(stack.InvokerLambdaOutputName)
+	if err != nil {
+		return fmt.Errorf("get invoker lambda for job %s in environment %s: %w", o.name, o.envName, err)
+	}
+	out, err := o.lambda.Invoke(functionName, payload)
+	if err != nil {
+		return fmt.Errorf("invoke job %s in environment %s: %w", o.name, o.envName, err)
+	}
+	var resp invokerResponse
+	if err := json.Unmarshal(out, &resp); err != nil || resp.ExecutionArn == "" {
+		return fmt.Errorf("parse invoker response for job %s in environment %s", o.name, o.envName)
+	}
+	return o.streamExecution(resp.ExecutionArn)
+}
+
+// streamExecution polls executionARN's status, printing each status change, until the execution
+// is no longer running, then prints its final output or failure reason.
+func (o *jobRunOpts) streamExecution(executionARN string) error {
+	var last string
+	for {
+		status, output, err := o.execution.Describe(executionARN)
+		if err != nil {
+			return fmt.Errorf("get execution status for job %s in environment %s: %w", o.name, o.envName, err)
+		}
+		if status != last {
+			fmt.Fprintf(o.w, "execution %s\n", status)
+			last = status
+		}
+		if status != sfn.ExecutionStatusRunning {
+			fmt.Fprintln(o.w, string(output))
+			return nil
+		}
+		o.sleep(executionPollInterval)
+	}
+}
+
+// payload reads the "--payload @file.json" flag into the raw bytes to send the invoker Lambda, or
+// returns nil if no payload was given.
+func (o *jobRunOpts) payload() ([]byte, error) {
+	if o.payloadPath == "" {
+		return nil, nil
+	}
+	path := strings.TrimPrefix(o.payloadPath, "@")
+	b, err := o.readFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read payload file %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// BuildJobRunCmd builds the "job run" command, which invokes a scheduled job's state machine on
+// demand. Exported so the root command can add it under the "job" command group alongside
+// "job init", "job deploy", etc.
+func BuildJobRunCmd() *cobra.Command {
+	vars := jobRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Invoke a scheduled job on demand.",
+		Example: `
+  Run the "report-generator" job in the "prod" environment.
+  /code $ copilot job run --app myapp --name report-generator --env prod
+
+  Run it with a custom payload.
+  /code $ copilot job run --app myapp --name report-generator --env prod --payload @event.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		},
+	}
+	cmd.Flags().StringVar(&vars.appName, "app", "", "Name of the application.")
+	cmd.Flags().StringVar(&vars.name, "name", "", "Name of the job.")
+	cmd.Flags().StringVar(&vars.envName, "env", "", "Name of the environment.")
+	cmd.Flags().StringVar(&vars.payloadPath, "payload", "", `Optional JSON payload to invoke the job with, as "@path/to/file.json".`)
+	return cmd
+}
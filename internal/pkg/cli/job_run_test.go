@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRunOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inputPayloadPath string
+		mockDependencies func(ctrl *gomock.Controller, o *jobRunOpts)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"invokes the job's invoker lambda and streams the execution to completion": {
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				describer := mocks.NewMockjobRunStackDescriber(ctrl)
+				describer.EXPECT().Output(stack.InvokerLambdaOutputName).Return("mailer-invoker", nil)
+				lambda := mocks.NewMocklambdaInvoker(ctrl)
+				lambda.EXPECT().Invoke("mailer-invoker", []byte(nil)).
+					Return([]byte(`{"executionArn":"arn:aws:states:us-west-2:123456789012:execution:mailer:123"}`), nil)
+				execution := mocks.NewMockexecutionDescriber(ctrl)
+				gomock.InOrder(
+					execution.EXPECT().Describe("arn:aws:states:us-west-2:123456789012:execution:mailer:123").Return("RUNNING", nil, nil),
+					execution.EXPECT().Describe("arn:aws:states:us-west-2:123456789012:execution:mailer:123").Return("SUCCEEDED", []byte(`{"sent":42}`), nil),
+				)
+				o.stackDescriber = describer
+				o.lambda = lambda
+				o.execution = execution
+				o.sleep = func(time.Duration) {}
+			},
+			wantedOutput: "execution RUNNING\nexecution SUCCEEDED\n{\"sent\":42}\n",
+		},
+		"reads the payload file when one is given": {
+			inputPayloadPath: "@event.json",
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				describer := mocks.NewMockjobRunStackDescriber(ctrl)
+				describer.EXPECT().Output(stack.InvokerLambdaOutputName).Return("mailer-invoker", nil)
+				lambda := mocks.NewMocklambdaInvoker(ctrl)
+				lambda.EXPECT().Invoke("mailer-invoker", []byte(`{"hello":"world"}`)).
+					Return([]byte(`{"executionArn":"arn:aws:states:us-west-2:123456789012:execution:mailer:123"}`), nil)
+				execution := mocks.NewMockexecutionDescriber(ctrl)
+				execution.EXPECT().Describe("arn:aws:states:us-west-2:123456789012:execution:mailer:123").Return("SUCCEEDED", []byte(`ok`), nil)
+				o.stackDescriber = describer
+				o.lambda = lambda
+				o.execution = execution
+				o.sleep = func(time.Duration) {}
+				o.readFile = func(path string) ([]byte, error) {
+					require.Equal(t, "event.json", path)
+					return []byte(`{"hello":"world"}`), nil
+				}
+			},
+			wantedOutput: "execution SUCCEEDED\nok\n",
+		},
+		"wraps an error resolving the invoker lambda": {
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				describer := mocks.NewMockjobRunStackDescriber(ctrl)
+				describer.EXPECT().Output(stack.InvokerLambdaOutputName).Return("", errors.New("some error"))
+				o.stackDescriber = describer
+			},
+			wantedError: errors.New("get invoker lambda for job mailer in environment test: some error"),
+		},
+		"wraps an error invoking the lambda": {
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				describer := mocks.NewMockjobRunStackDescriber(ctrl)
+				describer.EXPECT().Output(stack.InvokerLambdaOutputName).Return("mailer-invoker", nil)
+				lambda := mocks.NewMocklambdaInvoker(ctrl)
+				lambda.EXPECT().Invoke("mailer-invoker", []byte(nil)).Return(nil, errors.New("some error"))
+				o.stackDescriber = describer
+				o.lambda = lambda
+			},
+			wantedError: errors.New("invoke job mailer in environment test: some error"),
+		},
+		"wraps an error parsing the invoker lambda's response": {
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				describer := mocks.NewMockjobRunStackDescriber(ctrl)
+				describer.EXPECT().Output(stack.InvokerLambdaOutputName).Return("mailer-invoker", nil)
+				lambda := mocks.NewMocklambdaInvoker(ctrl)
+				lambda.EXPECT().Invoke("mailer-invoker", []byte(nil)).Return([]byte(`not json`), nil)
+				o.stackDescriber = describer
+				o.lambda = lambda
+			},
+			wantedError: errors.New("parse invoker response for job mailer in environment test"),
+		},
+		"wraps an error polling the execution status": {
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				describer := mocks.NewMockjobRunStackDescriber(ctrl)
+				describer.EXPECT().Output(stack.InvokerLambdaOutputName).Return("mailer-invoker", nil)
+				lambda := mocks.NewMocklambdaInvoker(ctrl)
+				lambda.EXPECT().Invoke("mailer-invoker", []byte(nil)).
+					Return([]byte(`{"executionArn":"arn:aws:states:us-west-2:123456789012:execution:mailer:123"}`), nil)
+				execution := mocks.NewMockexecutionDescriber(ctrl)
+				execution.EXPECT().Describe("arn:aws:states:us-west-2:123456789012:execution:mailer:123").Return("", nil, errors.New("some error"))
+				o.stackDescriber = describer
+				o.lambda = lambda
+				o.execution = execution
+			},
+			wantedError: errors.New("get execution status for job mailer in environment test: some error"),
+		},
+		"wraps an error reading the payload file": {
+			inputPayloadPath: "@missing.json",
+			mockDependencies: func(ctrl *gomock.Controller, o *jobRunOpts) {
+				o.readFile = func(path string) ([]byte, error) {
+					return nil, errors.New("no such file")
+				}
+			},
+			wantedError: errors.New("read payload file missing.json: no such file"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var buf bytes.Buffer
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName:     "cuteoverload",
+					name:        "mailer",
+					envName:     "test",
+					payloadPath: tc.inputPayloadPath,
+				},
+				sleep: func(time.Duration) {},
+				w:     &buf,
+			}
+			tc.mockDependencies(ctrl, opts)
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, buf.String())
+			}
+		})
+	}
+}
+
+func TestJobRunOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputVars   jobRunVars
+		wantedError error
+	}{
+		"valid": {
+			inputVars: jobRunVars{appName: "cuteoverload", name: "mailer", envName: "test"},
+		},
+		"missing app": {
+			inputVars:   jobRunVars{name: "mailer", envName: "test"},
+			wantedError: errors.New("--app is required"),
+		},
+		"missing name": {
+			inputVars:   jobRunVars{appName: "cuteoverload", envName: "test"},
+			wantedError: errors.New("--name is required"),
+		},
+		"missing env": {
+			inputVars:   jobRunVars{appName: "cuteoverload", name: "mailer"},
+			wantedError: errors.New("--env is required"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &jobRunOpts{jobRunVars: tc.inputVars}
+			err := opts.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+//go:generate mockgen -source interfaces.go -package mocks -destination mocks/mock_interfaces.go
+
+import "github.com/aws/copilot-cli/internal/pkg/template"
+
+// scheduledJobParser is the interface that wraps the ParseScheduledJob method.
+type scheduledJobParser interface {
+	ParseScheduledJob(data template.WorkloadOpts) (*template.Content, error)
+}
@@ -0,0 +1,465 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser validates schedule expressions against the set of rates, presets, and standard
+// cron fields that EventBridge-backed scheduled jobs support. It's only used for validation;
+// AWS's own cron dialect is produced separately by toAWSCron and toRate below.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// InvokerLambdaOutputName is the CloudFormation Output key a scheduled job's invoker Lambda
+// publishes its function name under, so callers like "copilot job run" can resolve it without
+// guessing a naming scheme.
+const InvokerLambdaOutputName = "InvokerLambdaFunction"
+
+// presetSchedules maps every preset descriptor Copilot accepts to the equivalent AWS cron
+// expression: @yearly/@annually, @monthly, @weekly, @daily/@midnight, and @hourly. Any other
+// "@"-prefixed descriptor (e.g. "@minutely") is rejected by cronParser before reaching this map.
+var presetSchedules = map[string]string{
+	"@yearly":   "cron(0 0 1 1 ? *)",
+	"@annually": "cron(0 0 1 1 ? *)",
+	"@monthly":  "cron(0 0 1 * ? *)",
+	"@weekly":   "cron(0 0 ? * 1 *)",
+	"@daily":    "cron(0 0 * * ? *)",
+	"@midnight": "cron(0 0 * * ? *)",
+	"@hourly":   "cron(0 * * * ? *)",
+}
+
+// ScheduledJob represents the configuration needed to create a CloudFormation stack from a
+// scheduled job manifest.
+type ScheduledJob struct {
+	*wkld
+	manifest *manifest.ScheduledJob
+
+	parser scheduledJobParser
+}
+
+// NewScheduledJob creates a new ScheduledJob stack from a manifest file, given the environment,
+// app name, and runtime configuration.
+func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConfig) (*ScheduledJob, error) {
+	addons, err := addon.ParseFromJob(aws.StringValue(mft.Name))
+	if err != nil {
+		var notFound *addon.ErrDirNotExist
+		if !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("new addons for job %s: %w", aws.StringValue(mft.Name), err)
+		}
+	}
+	return &ScheduledJob{
+		wkld: &wkld{
+			name:   aws.StringValue(mft.Name),
+			env:    env,
+			app:    app,
+			rc:     rc,
+			addons: addons,
+		},
+		manifest: mft,
+		parser:   template.New(),
+	}, nil
+}
+
+// Template returns the CloudFormation template for the scheduled job.
+func (j *ScheduledJob) Template() (string, error) {
+	addonsTpl, err := j.wkld.addons.Template()
+	var nestedStack *template.WorkloadNestedStackOpts
+	if err != nil {
+		var notFound *addon.ErrDirNotExist
+		if !errors.As(err, &notFound) {
+			return "", fmt.Errorf("generate addons template for %s: %w", j.name, err)
+		}
+	} else {
+		outputs, err := addon.Outputs(addonsTpl)
+		if err != nil {
+			return "", fmt.Errorf("parse addons outputs for %s: %w", j.name, err)
+		}
+		nestedStack = &template.WorkloadNestedStackOpts{
+			StackName: addon.StackName,
+		}
+		for _, out := range outputs {
+			switch {
+			case out.IsSecret:
+				nestedStack.SecretOutputs = append(nestedStack.SecretOutputs, out.Name)
+			case out.IsManagedPolicy:
+				nestedStack.PolicyOutputs = append(nestedStack.PolicyOutputs, out.Name)
+			default:
+				nestedStack.VariableOutputs = append(nestedStack.VariableOutputs, out.Name)
+			}
+		}
+	}
+
+	scheduleOpts, err := j.scheduleOptsList()
+	if err != nil {
+		return "", err
+	}
+	stateMachine, err := j.stateMachineOpts()
+	if err != nil {
+		return "", err
+	}
+
+	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
+		NestedStack:  nestedStack,
+		Schedules:    scheduleOpts,
+		StateMachine: stateMachine,
+		InvokerLambda: &template.InvokerLambdaOpts{
+			FunctionNameOutput: InvokerLambdaOutputName,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse scheduled job template: %w", err)
+	}
+	return content.String(), nil
+}
+
+// awsSchedule converts each of the manifest's schedule entries into the cron(...) or rate(...)
+// expression EventBridge understands, preserving the manifest's ordering so that redeploys
+// produce a stable, diff-friendly template.
+func (j *ScheduledJob) awsSchedule() ([]string, error) {
+	opts, err := j.scheduleOptsList()
+	if err != nil {
+		return nil, err
+	}
+	exprs := make([]string, len(opts))
+	for i, opt := range opts {
+		exprs[i] = opt.Expression
+	}
+	return exprs, nil
+}
+
+// scheduleOptsList converts every schedule entry declared in the manifest into the EventBridge
+// rule configuration needed to render it; one rule is emitted per entry.
+func (j *ScheduledJob) scheduleOptsList() ([]template.ScheduleOpts, error) {
+	schedules := j.manifest.Schedule
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("missing required field %q in manifest for job %s", "schedule", j.name)
+	}
+	opts := make([]template.ScheduleOpts, len(schedules))
+	for i, schedule := range schedules {
+		opt, err := j.scheduleOpts(schedule)
+		if err != nil {
+			return nil, err
+		}
+		opts[i] = opt
+	}
+	return opts, nil
+}
+
+// scheduleOpts converts a single manifest schedule entry: a recurring cron/rate/preset
+// expression, or an "@at" one-shot trigger.
+func (j *ScheduledJob) scheduleOpts(schedule string) (template.ScheduleOpts, error) {
+	if strings.HasPrefix(schedule, "@at ") {
+		expr, err := j.toOneShot(schedule)
+		if err != nil {
+			return template.ScheduleOpts{}, err
+		}
+		return template.ScheduleOpts{Expression: expr, OneShot: true}, nil
+	}
+	if _, err := cronParser.Parse(schedule); err != nil {
+		return template.ScheduleOpts{}, fmt.Errorf("schedule is not valid cron, rate, or preset: %w", err)
+	}
+	switch {
+	case strings.HasPrefix(schedule, "@every "):
+		if j.manifest.Timezone != "" {
+			return template.ScheduleOpts{}, fmt.Errorf("timezone is not supported for rate schedule %q; use an explicit cron expression instead", schedule)
+		}
+		expr, err := toRate(schedule)
+		if err != nil {
+			return template.ScheduleOpts{}, err
+		}
+		return template.ScheduleOpts{Expression: expr}, nil
+	case strings.HasPrefix(schedule, "@"):
+		if j.manifest.Timezone != "" {
+			return template.ScheduleOpts{}, fmt.Errorf("timezone is not supported for preset schedule %q; use an explicit cron expression instead", schedule)
+		}
+		return template.ScheduleOpts{Expression: presetSchedules[schedule]}, nil
+	default:
+		expr, err := toAWSCron(schedule, j.manifest.Timezone)
+		if err != nil {
+			return template.ScheduleOpts{}, err
+		}
+		return template.ScheduleOpts{Expression: expr, Description: j.scheduleDescription(schedule)}, nil
+	}
+}
+
+// toOneShot parses an "@at <RFC3339 timestamp>" schedule into a cron(...) expression pinned to
+// that exact minute, which EventBridge only ever evaluates once. A cleanup Lambda (rendered
+// alongside this rule) disables it once it fires, since EventBridge has no native one-shot rule.
+func (j *ScheduledJob) toOneShot(schedule string) (string, error) {
+	raw := strings.TrimPrefix(schedule, "@at ")
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return "", fmt.Errorf("parse one-shot schedule %q: %w", raw, err)
+	}
+	if !j.manifest.AllowPast && t.Before(time.Now()) {
+		return "", fmt.Errorf("one-shot schedule %s is in the past; set \"allow_past: true\" in the manifest to deploy it anyway", raw)
+	}
+	utc := t.UTC()
+	return fmt.Sprintf("cron(%d %d %d %d ? %d)", utc.Minute(), utc.Hour(), utc.Day(), int(utc.Month()), utc.Year()), nil
+}
+
+// scheduleDescription returns operator-facing context to attach to a schedule's generated
+// EventBridge rule as its CloudFormation Description. It's empty unless the manifest pins the
+// schedule to a timezone, since the raw cron/rate expression is otherwise already UTC and
+// self-explanatory.
+func (j *ScheduledJob) scheduleDescription(schedule string) string {
+	if j.manifest.Timezone == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s)", schedule, j.manifest.Timezone)
+}
+
+// toRate converts an "@every <duration>" descriptor into an EventBridge rate expression.
+// EventBridge only supports rates in whole minutes or hours, so the duration must divide evenly.
+func toRate(schedule string) (string, error) {
+	d, err := time.ParseDuration(strings.TrimPrefix(schedule, "@every "))
+	if err != nil {
+		return "", fmt.Errorf("parse fixed interval: %w", err)
+	}
+	if d < time.Minute {
+		return "", errors.New("parse fixed interval: duration must be greater than or equal to 1 minute")
+	}
+	switch {
+	case d%time.Hour == 0:
+		hrs := int(d / time.Hour)
+		if hrs == 1 {
+			return "rate(1 hour)", nil
+		}
+		return fmt.Sprintf("rate(%d hours)", hrs), nil
+	case d%time.Minute == 0:
+		mins := int(d / time.Minute)
+		if mins == 1 {
+			return "rate(1 minute)", nil
+		}
+		return fmt.Sprintf("rate(%d minutes)", mins), nil
+	default:
+		return "", errors.New("parse fixed interval: duration must be a whole number of minutes or hours")
+	}
+}
+
+// toAWSCron rewrites a standard 5-field cron expression into EventBridge's dialect, which
+// requires exactly one of day-of-month/day-of-week to be "?" and counts day-of-week from 1 (Sunday)
+// instead of 0. If tz is set, the minute/hour (and, if needed, day-of-week) fields are first
+// shifted from that IANA zone into UTC, since EventBridge schedules always run in UTC.
+func toAWSCron(schedule, tz string) (string, error) {
+	fields := strings.Fields(schedule)
+	minute, hour, dom, month, dow := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	domWildcard := dom == "*" || dom == "?"
+	dowWildcard := dow == "*" || dow == "?"
+	if !domWildcard && !dowWildcard {
+		return "", errors.New("parse cron schedule: cannot specify both DOW and DOM in cron expression")
+	}
+
+	var dayShift int
+	if tz != "" {
+		if !domWildcard {
+			return "", errors.New("timezone is only supported for schedules with a wildcard day-of-month")
+		}
+		localizedMinute, localizedHour, shift, err := localizeToUTC(minute, hour, tz)
+		if err != nil {
+			return "", fmt.Errorf("localize schedule to %s: %w", tz, err)
+		}
+		minute, hour = localizedMinute, localizedHour
+		dayShift = shift
+	}
+
+	switch {
+	case domWildcard && dowWildcard:
+		dom, dow = "*", "?"
+	case !domWildcard:
+		dow = "?"
+	case !dowWildcard:
+		dom = "?"
+		dow = shiftDOW(dow, dayShift)
+	}
+	return fmt.Sprintf("cron(%s %s %s %s %s *)", minute, hour, dom, month, dow), nil
+}
+
+// localizeToUTC converts an hour:minute expressed in tz into the equivalent UTC hour:minute,
+// along with the day shift (-1, 0, or 1) the conversion introduces. EventBridge has no notion of
+// timezones, so Copilot computes this shift once, using hr:min on the current date, and bakes
+// it into a plain UTC cron expression; the zone's UTC offset is assumed constant going forward.
+func localizeToUTC(minuteField, hourField, tz string) (minute, hour string, dayShift int, err error) {
+	min, err := strconv.Atoi(minuteField)
+	if err != nil {
+		return "", "", 0, errors.New("timezone requires a specific minute, not a wildcard or range")
+	}
+	hr, err := strconv.Atoi(hourField)
+	if err != nil {
+		return "", "", 0, errors.New("timezone requires a specific hour, not a wildcard or range")
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now().In(loc)
+	if badDate, skipped := dstTransitionHit(now.Year(), hr, min, loc); badDate != nil {
+		kind := "is ambiguous"
+		if skipped {
+			kind = "does not exist"
+		}
+		return "", "", 0, fmt.Errorf("%02d:%02d %s in %s on %s across a daylight saving time transition", hr, min, kind, tz, badDate.Format("2006-01-02"))
+	}
+
+	local := time.Date(now.Year(), now.Month(), now.Day(), hr, min, 0, 0, loc)
+	utc := local.UTC()
+	dayShift = utc.YearDay() - local.YearDay()
+	switch {
+	case dayShift > 1:
+		dayShift = -1
+	case dayShift < -1:
+		dayShift = 1
+	}
+	return strconv.Itoa(utc.Minute()), strconv.Itoa(utc.Hour()), dayShift, nil
+}
+
+// dstTransitionHit scans every day of year for a wall-clock hr:min in loc that a daylight saving
+// time transition makes skipped (spring-forward) or repeated (fall-back). A daily cron schedule
+// recurs on every date going forward, so checking only the current date misses the one or two
+// days a year the transition actually happens. It returns the first offending date found, along
+// with whether that date skips the time (as opposed to repeating it), or a nil date if hr:min is
+// well-defined on every day of the year.
+func dstTransitionHit(year, hr, min int, loc *time.Location) (date *time.Time, skipped bool) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	for d := 0; d < 366; d++ {
+		day := start.AddDate(0, 0, d)
+		local := time.Date(day.Year(), day.Month(), day.Day(), hr, min, 0, 0, loc)
+		if local.Hour() != hr || local.Minute() != min {
+			return &local, true
+		}
+		for _, neighbor := range []time.Time{local.Add(-time.Hour), local.Add(time.Hour)} {
+			if neighbor.Hour() == hr && neighbor.Minute() == min {
+				return &local, false
+			}
+		}
+	}
+	return nil, false
+}
+
+var weekdayNames = [7]string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// shiftDOW applies dayShift, the day offset introduced by a timezone conversion, to every
+// weekday token (a number 0-6 or a three-letter name) in a day-of-week cron field, leaving
+// list/range punctuation intact. Numeric tokens additionally get reindexed from cron's 0-indexed
+// Sunday to AWS's 1-indexed Sunday; named tokens (MON, TUE, ...) mean the same weekday under
+// either scheme, so they're left as-is aside from the timezone's day shift.
+func shiftDOW(dow string, dayShift int) string {
+	var shifted []string
+	for _, field := range strings.Split(dow, ",") {
+		parts := strings.SplitN(field, "-", 2)
+		for i, p := range parts {
+			parts[i] = shiftWeekdayToken(p, dayShift)
+		}
+		shifted = append(shifted, strings.Join(parts, "-"))
+	}
+	return strings.Join(shifted, ",")
+}
+
+func shiftWeekdayToken(tok string, dayShift int) string {
+	idx, isNumeric, ok := weekdayIndex(tok)
+	if !ok {
+		return tok
+	}
+	if !isNumeric {
+		shifted := ((idx+dayShift)%7 + 7) % 7
+		return weekdayNames[shifted]
+	}
+	shifted := ((idx+dayShift)%7+7)%7 + 1
+	return strconv.Itoa(shifted)
+}
+
+func weekdayIndex(tok string) (idx int, isNumeric, ok bool) {
+	if n, err := strconv.Atoi(tok); err == nil {
+		return n, true, true
+	}
+	for i, name := range weekdayNames {
+		if strings.EqualFold(tok, name) {
+			return i, false, true
+		}
+	}
+	return 0, false, false
+}
+
+// validConcurrencyPolicies are the values "concurrency" accepts in the manifest. An empty value
+// means "allow", the same as not setting concurrencyPolicy on a Kubernetes CronJob.
+var validConcurrencyPolicies = map[string]bool{
+	"":        true,
+	"allow":   true,
+	"forbid":  true,
+	"replace": true,
+}
+
+// stateMachineOpts converts the manifest's timeout, retries, on_failure, concurrency, and
+// starting_deadline fields into the Step Functions state machine configuration.
+func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
+	if j.manifest.Retries < 0 {
+		return nil, errors.New("number of retries cannot be negative")
+	}
+	opts := &template.StateMachineOpts{}
+	if j.manifest.Retries > 0 {
+		opts.Retries = aws.Int(j.manifest.Retries)
+	}
+	if j.manifest.Timeout != "" {
+		d, err := time.ParseDuration(j.manifest.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		if d < time.Second {
+			return nil, errors.New("timeout must be greater than or equal to 1 second")
+		}
+		if d%time.Second != 0 {
+			return nil, errors.New("timeout must be a whole number of seconds, minutes, or hours")
+		}
+		opts.Timeout = aws.Int(int(d.Seconds()))
+	}
+
+	if !validConcurrencyPolicies[j.manifest.Concurrency] {
+		return nil, fmt.Errorf("invalid concurrency policy %q in manifest for job %s: must be one of allow, forbid, replace", j.manifest.Concurrency, j.name)
+	}
+	opts.ConcurrencyPolicy = j.manifest.Concurrency
+	if opts.ConcurrencyPolicy == "" {
+		opts.ConcurrencyPolicy = "allow"
+	}
+	opts.ExecutionControlGrant = opts.ConcurrencyPolicy == "forbid" || opts.ConcurrencyPolicy == "replace"
+
+	if j.manifest.StartingDeadline != "" {
+		d, err := time.ParseDuration(j.manifest.StartingDeadline)
+		if err != nil {
+			return nil, err
+		}
+		if d < time.Second {
+			return nil, errors.New("starting_deadline must be greater than or equal to 1 second")
+		}
+		if d%time.Second != 0 {
+			return nil, errors.New("starting_deadline must be a whole number of seconds, minutes, or hours")
+		}
+		opts.StartingDeadline = aws.Int(int(d.Seconds()))
+	}
+
+	onFailure := j.manifest.OnFailure
+	if onFailure.PauseAfterFailures < 0 {
+		return nil, errors.New("pause_after_failures cannot be negative")
+	}
+	opts.DeadLetterQueue = onFailure.SQSDLQ
+	if onFailure.SNSTopic != "" || onFailure.PauseAfterFailures > 0 {
+		opts.FailureNotification = &template.FailureNotificationOpts{
+			SNSTopicARN:        onFailure.SNSTopic,
+			PauseAfterFailures: onFailure.PauseAfterFailures,
+		}
+	}
+	return opts, nil
+}
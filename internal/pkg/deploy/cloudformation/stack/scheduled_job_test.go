@@ -47,10 +47,14 @@ func TestScheduledJob_Template(t *testing.T) {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				m := mocks.NewMockscheduledJobParser(ctrl)
 				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
-					ScheduleExpression: "cron(0 0 * * ? *)",
+					Schedules: []template.ScheduleOpts{{Expression: "cron(0 0 * * ? *)"}},
 					StateMachine: &template.StateMachineOpts{
-						Timeout: aws.Int(5400),
-						Retries: aws.Int(3),
+						Timeout:           aws.Int(5400),
+						Retries:           aws.Int(3),
+						ConcurrencyPolicy: "allow",
+					},
+					InvokerLambda: &template.InvokerLambdaOpts{
+						FunctionNameOutput: "InvokerLambdaFunction",
 					},
 				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
@@ -69,10 +73,14 @@ func TestScheduledJob_Template(t *testing.T) {
 						SecretOutputs:   []string{"MySecretArn"},
 						PolicyOutputs:   []string{"AdditionalResourcesPolicyArn"},
 					},
-					ScheduleExpression: "cron(0 0 * * ? *)",
+					Schedules: []template.ScheduleOpts{{Expression: "cron(0 0 * * ? *)"}},
 					StateMachine: &template.StateMachineOpts{
-						Timeout: aws.Int(5400),
-						Retries: aws.Int(3),
+						Timeout:           aws.Int(5400),
+						Retries:           aws.Int(3),
+						ConcurrencyPolicy: "allow",
+					},
+					InvokerLambda: &template.InvokerLambdaOpts{
+						FunctionNameOutput: "InvokerLambdaFunction",
 					},
 				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
@@ -107,6 +115,108 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"render template with dead-letter queue, sns notification, and circuit breaker": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
+					Schedules: []template.ScheduleOpts{{Expression: "cron(0 0 * * ? *)"}},
+					StateMachine: &template.StateMachineOpts{
+						Timeout:         aws.Int(5400),
+						Retries:         aws.Int(3),
+						DeadLetterQueue: true,
+						FailureNotification: &template.FailureNotificationOpts{
+							SNSTopicARN:        "arn:aws:sns:us-west-2:123456789012:job-failures",
+							PauseAfterFailures: 5,
+						},
+						ConcurrencyPolicy: "allow",
+					},
+					InvokerLambda: &template.InvokerLambdaOpts{
+						FunctionNameOutput: "InvokerLambdaFunction",
+					},
+				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
+				j.manifest = manifest.NewScheduledJob(manifest.ScheduledJobProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "mailer",
+						Dockerfile: "mailer/Dockerfile",
+					},
+					Schedule: "@daily",
+					Timeout:  "1h30m",
+					Retries:  3,
+				})
+				j.manifest.OnFailure = manifest.OnFailureConfig{
+					SQSDLQ:             true,
+					SNSTopic:           "arn:aws:sns:us-west-2:123456789012:job-failures",
+					PauseAfterFailures: 5,
+				}
+				j.parser = m
+				j.wkld.addons = addons
+			},
+			wantedTemplate: "template",
+		},
+		"render template with multiple schedules including an @at one-shot trigger": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
+					Schedules: []template.ScheduleOpts{
+						{Expression: "cron(0 0 * * ? *)"},
+						{Expression: "cron(0 9 1 12 ? 2099)", OneShot: true},
+					},
+					StateMachine: &template.StateMachineOpts{
+						Timeout:           aws.Int(5400),
+						Retries:           aws.Int(3),
+						ConcurrencyPolicy: "allow",
+					},
+					InvokerLambda: &template.InvokerLambdaOpts{
+						FunctionNameOutput: "InvokerLambdaFunction",
+					},
+				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
+				j.manifest = manifest.NewScheduledJob(manifest.ScheduledJobProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "mailer",
+						Dockerfile: "mailer/Dockerfile",
+					},
+					Timeout: "1h30m",
+					Retries: 3,
+				})
+				j.manifest.Schedule = manifest.ScheduleList{"@daily", "@at 2099-12-01T09:00:00Z"}
+				j.parser = m
+				j.wkld.addons = addons
+			},
+			wantedTemplate: "template",
+		},
+		"render template with non-default concurrency and invoker lambda": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Eq(template.WorkloadOpts{
+					Schedules: []template.ScheduleOpts{{Expression: "cron(0 0 * * ? *)"}},
+					StateMachine: &template.StateMachineOpts{
+						Timeout:               aws.Int(5400),
+						Retries:               aws.Int(3),
+						ConcurrencyPolicy:     "replace",
+						ExecutionControlGrant: true,
+					},
+					InvokerLambda: &template.InvokerLambdaOpts{
+						FunctionNameOutput: "InvokerLambdaFunction",
+					},
+				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
+				j.manifest = manifest.NewScheduledJob(manifest.ScheduledJobProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "mailer",
+						Dockerfile: "mailer/Dockerfile",
+					},
+					Schedule: "@daily",
+					Timeout:  "1h30m",
+					Retries:  3,
+				})
+				j.manifest.Concurrency = "replace"
+				j.parser = m
+				j.wkld.addons = addons
+			},
+			wantedTemplate: "template",
+		},
 		"error parsing addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				m := mocks.NewMockscheduledJobParser(ctrl)
@@ -164,9 +274,14 @@ Outputs:
 
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
-		inputSchedule  string
-		wantedSchedule string
-		wantedError    error
+		inputSchedule        string
+		inputSchedules       []string
+		inputAllowPast       bool
+		inputTimezone        string
+		wantedSchedule       string
+		wantedSchedules      []string
+		wantedError          error
+		wantedErrorSubstring string
 	}{
 		"simple rate": {
 			inputSchedule:  "@every 1h30m",
@@ -264,10 +379,73 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule: "* * * malformed *",
 			wantedError:   errors.New("schedule is not valid cron, rate, or preset: failed to parse int from malformed: strconv.Atoi: parsing \"malformed\": invalid syntax"),
 		},
+		"multiple schedules render in manifest order": {
+			inputSchedules:  []string{"@daily", "@every 1h", "@hourly"},
+			wantedSchedules: []string{"cron(0 0 * * ? *)", "rate(1 hour)", "cron(0 * * * ? *)"},
+		},
+		"one-shot schedule in the future": {
+			inputSchedule:  "@at 2099-12-01T09:00:00Z",
+			wantedSchedule: "cron(0 9 1 12 ? 2099)",
+		},
+		"one-shot schedule in the past is rejected": {
+			inputSchedule: "@at 2000-01-01T00:00:00Z",
+			wantedError:   errors.New("one-shot schedule 2000-01-01T00:00:00Z is in the past; set \"allow_past: true\" in the manifest to deploy it anyway"),
+		},
+		"one-shot schedule in the past is allowed with allow_past": {
+			inputSchedule:  "@at 2000-01-01T00:00:00Z",
+			inputAllowPast: true,
+			wantedSchedule: "cron(0 0 1 1 ? 2000)",
+		},
+		"one-shot schedule with invalid timestamp": {
+			inputSchedule: "@at not-a-timestamp",
+			wantedError:   errors.New(`parse one-shot schedule "not-a-timestamp": parsing time "not-a-timestamp" as "2006-01-02T15:04:05Z07:00": cannot parse "not-a-timestamp" as "2006"`),
+		},
+		"timezone shifts the schedule across a day boundary, including its DOW": {
+			inputSchedule:  "0 3 ? * MON",
+			inputTimezone:  "Asia/Tokyo",
+			wantedSchedule: "cron(0 18 ? * SUN *)",
+		},
+		"timezone with a wildcard DOW doesn't need a day shift": {
+			inputSchedule:  "0 3 * * *",
+			inputTimezone:  "Asia/Tokyo",
+			wantedSchedule: "cron(0 18 * * ? *)",
+		},
+		"invalid timezone": {
+			inputSchedule: "0 9 * * *",
+			inputTimezone: "Not/AZone",
+			wantedError:   errors.New("localize schedule to Not/AZone: invalid timezone: unknown time zone Not/AZone"),
+		},
+		"timezone is rejected alongside a specified DOM": {
+			inputSchedule: "0 9 1 * *",
+			inputTimezone: "Asia/Tokyo",
+			wantedError:   errors.New("timezone is only supported for schedules with a wildcard day-of-month"),
+		},
+		"timezone requires a specific hour and minute, not a wildcard": {
+			inputSchedule: "* 9 * * *",
+			inputTimezone: "Asia/Tokyo",
+			wantedError:   errors.New("localize schedule to Asia/Tokyo: timezone requires a specific minute, not a wildcard or range"),
+		},
+		"local time that's skipped by a DST spring-forward transition is rejected": {
+			inputSchedule:        "30 2 * * *",
+			inputTimezone:        "America/New_York",
+			wantedErrorSubstring: "02:30 does not exist in America/New_York on",
+		},
+		"local time that's repeated by a DST fall-back transition is rejected": {
+			inputSchedule:        "30 1 * * *",
+			inputTimezone:        "America/New_York",
+			wantedErrorSubstring: "01:30 is ambiguous in America/New_York on",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
+			var schedule manifest.ScheduleList
+			switch {
+			case tc.inputSchedules != nil:
+				schedule = manifest.ScheduleList(tc.inputSchedules)
+			case tc.inputSchedule != "":
+				schedule = manifest.ScheduleList{tc.inputSchedule}
+			}
 			job := &ScheduledJob{
 				wkld: &wkld{
 					name: "mailer",
@@ -275,7 +453,9 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						ScheduleConfig: manifest.ScheduleConfig{
-							Schedule: tc.inputSchedule,
+							Schedule:  schedule,
+							AllowPast: tc.inputAllowPast,
+							Timezone:  tc.inputTimezone,
 						},
 					},
 				},
@@ -284,11 +464,19 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			parsedSchedule, err := job.awsSchedule()
 
 			// THEN
-			if tc.wantedError != nil {
+			switch {
+			case tc.wantedErrorSubstring != "":
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantedErrorSubstring)
+			case tc.wantedError != nil:
 				require.EqualError(t, err, tc.wantedError.Error())
-			} else {
+			default:
 				require.NoError(t, err)
-				require.Equal(t, tc.wantedSchedule, parsedSchedule)
+				wanted := tc.wantedSchedules
+				if wanted == nil {
+					wanted = []string{tc.wantedSchedule}
+				}
+				require.Equal(t, wanted, parsedSchedule)
 			}
 		})
 	}
@@ -296,31 +484,37 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
-		inputTimeout string
-		inputRetries int
-		wantedConfig template.StateMachineOpts
-		wantedError  error
+		inputTimeout          string
+		inputRetries          int
+		inputOnFailure        manifest.OnFailureConfig
+		inputConcurrency      string
+		inputStartingDeadline string
+		wantedConfig          template.StateMachineOpts
+		wantedError           error
 	}{
 		"timeout and retries": {
 			inputTimeout: "3h",
 			inputRetries: 5,
 			wantedConfig: template.StateMachineOpts{
-				Timeout: aws.Int(10800),
-				Retries: aws.Int(5),
+				Timeout:           aws.Int(10800),
+				Retries:           aws.Int(5),
+				ConcurrencyPolicy: "allow",
 			},
 		},
 		"just timeout": {
 			inputTimeout: "1h",
 			wantedConfig: template.StateMachineOpts{
-				Timeout: aws.Int(3600),
-				Retries: nil,
+				Timeout:           aws.Int(3600),
+				Retries:           nil,
+				ConcurrencyPolicy: "allow",
 			},
 		},
 		"just retries": {
 			inputRetries: 2,
 			wantedConfig: template.StateMachineOpts{
-				Timeout: nil,
-				Retries: aws.Int(2),
+				Timeout:           nil,
+				Retries:           aws.Int(2),
+				ConcurrencyPolicy: "allow",
 			},
 		},
 		"negative retries": {
@@ -339,6 +533,87 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 			inputTimeout: "1s40ms",
 			wantedError:  errors.New("timeout must be a whole number of seconds, minutes, or hours"),
 		},
+		"dead letter queue only": {
+			inputOnFailure: manifest.OnFailureConfig{SQSDLQ: true},
+			wantedConfig:   template.StateMachineOpts{DeadLetterQueue: true, ConcurrencyPolicy: "allow"},
+		},
+		"sns topic notification": {
+			inputOnFailure: manifest.OnFailureConfig{SNSTopic: "arn:aws:sns:us-west-2:123456789012:job-failures"},
+			wantedConfig: template.StateMachineOpts{
+				FailureNotification: &template.FailureNotificationOpts{
+					SNSTopicARN: "arn:aws:sns:us-west-2:123456789012:job-failures",
+				},
+				ConcurrencyPolicy: "allow",
+			},
+		},
+		"circuit breaker without sns topic": {
+			inputOnFailure: manifest.OnFailureConfig{PauseAfterFailures: 3},
+			wantedConfig: template.StateMachineOpts{
+				FailureNotification: &template.FailureNotificationOpts{PauseAfterFailures: 3},
+				ConcurrencyPolicy:   "allow",
+			},
+		},
+		"dlq, sns topic, and circuit breaker together": {
+			inputOnFailure: manifest.OnFailureConfig{
+				SQSDLQ:             true,
+				SNSTopic:           "arn:aws:sns:us-west-2:123456789012:job-failures",
+				PauseAfterFailures: 5,
+			},
+			wantedConfig: template.StateMachineOpts{
+				DeadLetterQueue: true,
+				FailureNotification: &template.FailureNotificationOpts{
+					SNSTopicARN:        "arn:aws:sns:us-west-2:123456789012:job-failures",
+					PauseAfterFailures: 5,
+				},
+				ConcurrencyPolicy: "allow",
+			},
+		},
+		"negative pause_after_failures": {
+			inputOnFailure: manifest.OnFailureConfig{PauseAfterFailures: -1},
+			wantedError:    errors.New("pause_after_failures cannot be negative"),
+		},
+		"default concurrency policy is allow": {
+			wantedConfig: template.StateMachineOpts{ConcurrencyPolicy: "allow"},
+		},
+		"forbid concurrency requires execution control grant": {
+			inputConcurrency: "forbid",
+			wantedConfig: template.StateMachineOpts{
+				ConcurrencyPolicy:     "forbid",
+				ExecutionControlGrant: true,
+			},
+		},
+		"replace concurrency requires execution control grant": {
+			inputConcurrency: "replace",
+			wantedConfig: template.StateMachineOpts{
+				ConcurrencyPolicy:     "replace",
+				ExecutionControlGrant: true,
+			},
+		},
+		"allow concurrency does not require execution control grant": {
+			inputConcurrency: "allow",
+			wantedConfig: template.StateMachineOpts{
+				ConcurrencyPolicy: "allow",
+			},
+		},
+		"invalid concurrency policy": {
+			inputConcurrency: "kill",
+			wantedError:      errors.New(`invalid concurrency policy "kill" in manifest for job mailer: must be one of allow, forbid, replace`),
+		},
+		"starting deadline": {
+			inputStartingDeadline: "5m",
+			wantedConfig: template.StateMachineOpts{
+				ConcurrencyPolicy: "allow",
+				StartingDeadline:  aws.Int(300),
+			},
+		},
+		"starting deadline too small": {
+			inputStartingDeadline: "500ms",
+			wantedError:           errors.New("starting_deadline must be greater than or equal to 1 second"),
+		},
+		"starting deadline non-integer number of seconds": {
+			inputStartingDeadline: "1s40ms",
+			wantedError:           errors.New("starting_deadline must be a whole number of seconds, minutes, or hours"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -350,9 +625,12 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						ScheduleConfig: manifest.ScheduleConfig{
-							Retries: tc.inputRetries,
-							Timeout: tc.inputTimeout,
+							Retries:          tc.inputRetries,
+							Timeout:          tc.inputTimeout,
+							Concurrency:      tc.inputConcurrency,
+							StartingDeadline: tc.inputStartingDeadline,
 						},
+						OnFailure: tc.inputOnFailure,
 					},
 				},
 			}
@@ -366,6 +644,11 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Retries), aws.IntValue(parsedStateMachine.Retries))
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Timeout), aws.IntValue(parsedStateMachine.Timeout))
+				require.Equal(t, tc.wantedConfig.DeadLetterQueue, parsedStateMachine.DeadLetterQueue)
+				require.Equal(t, tc.wantedConfig.FailureNotification, parsedStateMachine.FailureNotification)
+				require.Equal(t, tc.wantedConfig.ConcurrencyPolicy, parsedStateMachine.ConcurrencyPolicy)
+				require.Equal(t, tc.wantedConfig.ExecutionControlGrant, parsedStateMachine.ExecutionControlGrant)
+				require.Equal(t, aws.IntValue(tc.wantedConfig.StartingDeadline), aws.IntValue(parsedStateMachine.StartingDeadline))
 			}
 		})
 	}
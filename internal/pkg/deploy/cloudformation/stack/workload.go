@@ -0,0 +1,33 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stack renders the CloudFormation stacks that back Copilot's workloads and environments.
+package stack
+
+// templater is the interface that wraps the Template method for rendering a workload's
+// addons nested stack.
+type templater interface {
+	Template() (string, error)
+}
+
+// ECRImage represents configuration about an existing ECR image that's associated with a workload.
+type ECRImage struct {
+	RepoURL  string
+	ImageTag string
+}
+
+// RuntimeConfig represents configuration that's defined outside of the manifest and that needs
+// to be injected into a workload stack, such as the built container image location.
+type RuntimeConfig struct {
+	Image *ECRImage
+}
+
+// wkld holds the fields shared by every workload stack, be it a service or a job.
+type wkld struct {
+	name string
+	env  string
+	app  string
+	rc   RuntimeConfig
+
+	addons templater
+}
@@ -0,0 +1,220 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"gopkg.in/yaml.v3"
+)
+
+// ScheduledJobType identifies that a job's workload type is "Scheduled Job".
+const ScheduledJobType = "Scheduled Job"
+
+// WorkloadProps contains properties for creating a new workload manifest.
+type WorkloadProps struct {
+	Name       string
+	Dockerfile string
+}
+
+// ScheduleConfig holds the fields needed to trigger a job on one or more schedules, fixed
+// intervals, or a one-shot run at a specific time.
+type ScheduleConfig struct {
+	Schedule ScheduleList `yaml:"schedule"`
+	Timeout  string       `yaml:"timeout"`
+	Retries  int          `yaml:"retries"`
+
+	// Timezone is an optional IANA time zone name (e.g. "America/Los_Angeles") that a cron
+	// schedule is interpreted in before being converted to the UTC expression EventBridge
+	// requires. It only applies to explicit cron expressions: combining it with a preset
+	// ("@daily", "@hourly", ...) or an "@every" rate, neither of which has a concrete hour and
+	// minute to localize, is rejected.
+	Timezone string `yaml:"timezone"`
+
+	// AllowPast permits an "@at <RFC3339 timestamp>" one-shot schedule whose time has already
+	// passed. Without it, a past "@at" schedule is rejected as most likely a mistake.
+	AllowPast bool `yaml:"allow_past"`
+
+	// Concurrency controls what happens when a schedule fires while a previous execution is
+	// still running: "allow" (the default) lets them run side by side, "forbid" skips the new
+	// firing, and "replace" stops the running execution and starts the new one.
+	Concurrency string `yaml:"concurrency"`
+
+	// StartingDeadline is the maximum duration, expressed like "1h30m", a missed firing can be
+	// stale before it's dropped instead of started late. Empty means firings are never dropped
+	// for staleness.
+	StartingDeadline string `yaml:"starting_deadline"`
+}
+
+// ScheduleList holds the one or more schedule expressions configured for a job. The manifest may
+// spell "schedule" as a single string or as a list of strings; both unmarshal into this type so
+// the rest of Copilot can always treat a job as having a list of schedules.
+type ScheduleList []string
+
+// UnmarshalYAML lets "schedule" be either a single scalar ("@daily") or a sequence of them
+// (["@daily", "@at 2024-12-01T09:00:00Z"]).
+func (l *ScheduleList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		var s string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+		*l = ScheduleList{s}
+		return nil
+	case yaml.SequenceNode:
+		var s []string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+		*l = s
+		return nil
+	default:
+		return fmt.Errorf("unrecognized YAML node kind %v for field \"schedule\"", value.Kind)
+	}
+}
+
+// ScheduledJobConfig holds the configuration that can be set for a scheduled job.
+type ScheduledJobConfig struct {
+	ScheduleConfig `yaml:",inline"`
+
+	// OnFailure configures what happens when the job's executions repeatedly fail.
+	OnFailure OnFailureConfig `yaml:"on_failure"`
+}
+
+// OnFailureConfig holds the configuration for reacting to a scheduled job's failed executions:
+// routing them to a dead-letter queue, notifying an SNS topic, and/or pausing the schedule after
+// too many failures in a row.
+type OnFailureConfig struct {
+	// SNSTopic is the ARN of an existing SNS topic to notify when an execution fails.
+	SNSTopic string `yaml:"sns_topic"`
+	// SQSDLQ, if true, adds a dead-letter queue that captures the failed execution's ARN and
+	// input for later inspection or replay.
+	SQSDLQ bool `yaml:"sqs_dlq"`
+	// PauseAfterFailures disables the schedule after this many consecutive failures, requiring
+	// an operator to manually re-enable it. Zero disables the circuit breaker.
+	PauseAfterFailures int `yaml:"pause_after_failures"`
+}
+
+// scheduleTypePresets maps a structured schedule's "type" to the preset descriptor that
+// produces the equivalent cron expression.
+var scheduleTypePresets = map[string]string{
+	"daily":   "@daily",
+	"weekly":  "@weekly",
+	"monthly": "@monthly",
+}
+
+// UnmarshalYAML lets the "schedule" field accept either the raw string form Copilot has always
+// supported ("@daily", "0 9 * * *", "@every 1h"), or a structured form that mirrors Harbor's GC
+// scheduling API:
+//
+//	schedule:
+//	  type: daily  # one of daily, weekly, monthly, custom.
+//	  cron: "0 9 * * *" # required, and used as-is, when type is custom.
+//
+// The structured form is normalized down to the same raw string the rest of Copilot already
+// knows how to validate and convert into an EventBridge schedule.
+func (c *ScheduledJobConfig) UnmarshalYAML(value *yaml.Node) error {
+	type scheduledJobConfig ScheduledJobConfig // prevent recursing back into this method.
+	if !hasStructuredSchedule(value) {
+		return value.Decode((*scheduledJobConfig)(c))
+	}
+
+	var structured struct {
+		Schedule struct {
+			Type string `yaml:"type"`
+			Cron string `yaml:"cron"`
+		} `yaml:"schedule"`
+		Timeout          string          `yaml:"timeout"`
+		Retries          int             `yaml:"retries"`
+		Timezone         string          `yaml:"timezone"`
+		AllowPast        bool            `yaml:"allow_past"`
+		Concurrency      string          `yaml:"concurrency"`
+		StartingDeadline string          `yaml:"starting_deadline"`
+		OnFailure        OnFailureConfig `yaml:"on_failure"`
+	}
+	if err := value.Decode(&structured); err != nil {
+		return err
+	}
+	schedule, err := scheduleFromType(structured.Schedule.Type, structured.Schedule.Cron)
+	if err != nil {
+		return err
+	}
+	c.Schedule = ScheduleList{schedule}
+	c.Timeout = structured.Timeout
+	c.Retries = structured.Retries
+	c.Timezone = structured.Timezone
+	c.AllowPast = structured.AllowPast
+	c.Concurrency = structured.Concurrency
+	c.StartingDeadline = structured.StartingDeadline
+	c.OnFailure = structured.OnFailure
+	return nil
+}
+
+// hasStructuredSchedule reports whether value's "schedule" field is the structured
+// {type, cron} form rather than the raw scalar/sequence form ScheduleList already understands.
+// Checking the node's shape, rather than retrying on any decode error, keeps a genuine mistake
+// elsewhere in the manifest (e.g. a malformed "retries") from being misreported as a schedule
+// problem.
+func hasStructuredSchedule(value *yaml.Node) bool {
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		if value.Content[i].Value == "schedule" {
+			return value.Content[i+1].Kind == yaml.MappingNode
+		}
+	}
+	return false
+}
+
+// scheduleFromType synthesizes the cron expression for a structured schedule's preset "type",
+// or passes a "custom" type's raw cron expression through unchanged.
+func scheduleFromType(typ, cron string) (string, error) {
+	if typ == "custom" {
+		if cron == "" {
+			return "", fmt.Errorf(`"cron" is required in manifest when schedule type is "custom"`)
+		}
+		return cron, nil
+	}
+	preset, ok := scheduleTypePresets[typ]
+	if !ok {
+		return "", fmt.Errorf("unrecognized schedule type %q in manifest: must be one of daily, weekly, monthly, custom", typ)
+	}
+	return preset, nil
+}
+
+// ScheduledJob holds the configuration to build a container image that's run on a schedule
+// or at a fixed interval, rather than in response to a request or event.
+type ScheduledJob struct {
+	Name *string `yaml:"name"`
+	Type *string `yaml:"type"`
+
+	ScheduledJobConfig `yaml:",inline"`
+}
+
+// ScheduledJobProps contains properties for creating a new scheduled job manifest.
+type ScheduledJobProps struct {
+	*WorkloadProps
+	Schedule string
+	Timeout  string
+	Retries  int
+}
+
+// NewScheduledJob creates a new scheduled job manifest with default values.
+func NewScheduledJob(props ScheduledJobProps) *ScheduledJob {
+	var schedule ScheduleList
+	if props.Schedule != "" {
+		schedule = ScheduleList{props.Schedule}
+	}
+	return &ScheduledJob{
+		Name: aws.String(props.WorkloadProps.Name),
+		Type: aws.String(ScheduledJobType),
+		ScheduledJobConfig: ScheduledJobConfig{
+			ScheduleConfig: ScheduleConfig{
+				Schedule: schedule,
+				Timeout:  props.Timeout,
+				Retries:  props.Retries,
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestScheduledJobConfig_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedSchedule ScheduleList
+		wantedTimezone string
+		wantedError    string
+	}{
+		"string schedule": {
+			in: `
+schedule: "@daily"
+timezone: America/Los_Angeles
+`,
+			wantedSchedule: ScheduleList{"@daily"},
+			wantedTimezone: "America/Los_Angeles",
+		},
+		"structured preset schedule": {
+			in: `
+schedule:
+  type: weekly
+`,
+			wantedSchedule: ScheduleList{"@weekly"},
+		},
+		"structured custom schedule passes the cron through unchanged": {
+			in: `
+schedule:
+  type: custom
+  cron: "0 9 * * *"
+timezone: Asia/Tokyo
+`,
+			wantedSchedule: ScheduleList{"0 9 * * *"},
+			wantedTimezone: "Asia/Tokyo",
+		},
+		"structured custom schedule missing cron": {
+			in: `
+schedule:
+  type: custom
+`,
+			wantedError: `"cron" is required in manifest when schedule type is "custom"`,
+		},
+		"structured schedule with an unrecognized type": {
+			in: `
+schedule:
+  type: biweekly
+`,
+			wantedError: `unrecognized schedule type "biweekly" in manifest: must be one of daily, weekly, monthly, custom`,
+		},
+		"malformed manifest unrelated to schedule surfaces its own error": {
+			in: `
+schedule: "@daily"
+retries: "not a number"
+`,
+			wantedError: "line 3: cannot unmarshal !!str `not a n...` into int",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var c ScheduledJobConfig
+
+			// WHEN
+			err := yaml.Unmarshal([]byte(tc.in), &c)
+
+			// THEN
+			if tc.wantedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSchedule, c.Schedule)
+			require.Equal(t, tc.wantedTimezone, c.Timezone)
+		})
+	}
+}
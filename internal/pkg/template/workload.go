@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package template renders the CloudFormation templates that back Copilot's workloads.
+package template
+
+import "bytes"
+
+// Content represents the rendered content of a CloudFormation template.
+type Content struct {
+	Buffer *bytes.Buffer
+}
+
+// String returns the template content as a string.
+func (c *Content) String() string {
+	return c.Buffer.String()
+}
+
+// WorkloadNestedStackOpts holds configuration needed if the workload stack has a nested stack,
+// such as the addons stack.
+type WorkloadNestedStackOpts struct {
+	StackName       string
+	VariableOutputs []string
+	SecretOutputs   []string
+	PolicyOutputs   []string
+}
+
+// StateMachineOpts holds configuration needed to render the Step Functions state machine that
+// orchestrates a scheduled job's task execution.
+type StateMachineOpts struct {
+	Timeout *int
+	Retries *int
+
+	// DeadLetterQueue, if true, adds an SQS queue with a redrive policy that the state machine's
+	// failure branch writes the failed execution's ARN and input to.
+	DeadLetterQueue bool
+	// FailureNotification configures an SNS notification, and optionally a circuit breaker, for
+	// repeated execution failures. Nil disables both.
+	FailureNotification *FailureNotificationOpts
+
+	// ConcurrencyPolicy is one of "allow", "forbid", or "replace", controlling what the state
+	// machine does about an execution that's still running when the schedule fires again.
+	ConcurrencyPolicy string
+	// ExecutionControlGrant, if true, adds states:ListExecutions and states:StopExecution to the
+	// state machine's IAM role, needed by the "forbid" and "replace" concurrency policies.
+	ExecutionControlGrant bool
+	// StartingDeadline, in seconds, is the maximum staleness a missed firing can have before the
+	// state machine drops it instead of starting it late. Nil means firings are never dropped.
+	StartingDeadline *int
+}
+
+// FailureNotificationOpts holds the configuration needed to notify an SNS topic of a scheduled
+// job's failed executions and, optionally, pause its schedule after too many in a row.
+type FailureNotificationOpts struct {
+	// SNSTopicARN is the topic an EventBridge rule on states.ExecutionFailed publishes to. Empty
+	// disables the SNS subscription while still allowing the pause-after-failures circuit
+	// breaker below.
+	SNSTopicARN string
+	// PauseAfterFailures disables the job's schedule rule once this many consecutive executions
+	// have failed within the rule's evaluation window. Zero disables the circuit breaker.
+	PauseAfterFailures int
+}
+
+// WorkloadOpts holds optional data that can be used to render a workload's CloudFormation template.
+type WorkloadOpts struct {
+	NestedStack *WorkloadNestedStackOpts
+
+	// Scheduled Job specific configuration.
+	Schedules     []ScheduleOpts
+	StateMachine  *StateMachineOpts
+	InvokerLambda *InvokerLambdaOpts
+}
+
+// InvokerLambdaOpts holds the configuration for the on-demand Lambda that lets operators start an
+// execution of a scheduled job's state machine outside of its normal schedule, e.g. via
+// "copilot job run".
+type InvokerLambdaOpts struct {
+	// FunctionNameOutput is the key of the CloudFormation Output the invoker Lambda's function
+	// name is published under, so callers can resolve it by name instead of guessing how it was
+	// generated.
+	FunctionNameOutput string
+}
+
+// ScheduleOpts holds the configuration for a single EventBridge rule that triggers a scheduled
+// job's state machine. A job renders one rule per entry in its manifest's "schedule" list.
+type ScheduleOpts struct {
+	// Expression is the rate(...) or cron(...) expression EventBridge evaluates.
+	Expression string
+	// Description, if set, is attached to the generated rule as its CloudFormation Description,
+	// e.g. to record the original local-time expression behind a timezone conversion.
+	Description string
+	// OneShot marks a rule synthesized from an "@at" trigger: it fires exactly once and is
+	// disabled afterwards by a small cleanup Lambda, rather than recurring.
+	OneShot bool
+}
+
+// Template is responsible for parsing Copilot's CloudFormation templates.
+type Template struct{}
+
+// New returns a Template object that can be used to parse Copilot's templates.
+func New() *Template {
+	return &Template{}
+}
+
+// ParseScheduledJob parses a scheduled job's CloudFormation template with the specified data.
+func (t *Template) ParseScheduledJob(data WorkloadOpts) (*Content, error) {
+	return t.parse("workloads/jobs/scheduled-job/cf.yml", data)
+}
+
+func (t *Template) parse(path string, data interface{}) (*Content, error) {
+	// Rendered from the embedded templates under templates/workloads. Omitted from this
+	// checkout; see templates/ for the source YAML.
+	return nil, nil
+}